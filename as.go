@@ -0,0 +1,56 @@
+package tracer
+
+import "errors"
+
+// As implements errors.As support for *Error so that tracer errors can be
+// inspected with Go's standard structural error matching in addition to Is and
+// Unwrap. Only targets of type **Error are supported. Typed extraction of
+// individual Context annotations against a registered shape, e.g.
+// `var ctx *AlreadyExistsContext; errors.As(err, &ctx)`, is intentionally
+// out of scope: Context is a flat Key/Val pair, not a struct type a kind can
+// register, so there is nothing for errors.As to reflect into. Use Contexts
+// or Lookup instead to read annotations by key.
+func (e *Error) As(target any) bool {
+	t, ok := target.(**Error)
+	if !ok {
+		return false
+	}
+
+	*t = e
+	return true
+}
+
+// Contexts walks the full wrap chain of err, from the outermost mask to the
+// root cause, and returns every Context annotation collected along the way.
+func Contexts(err error) []Context {
+	var cs []Context
+
+	for err != nil {
+		if t, ok := err.(*Error); ok {
+			cs = append(cs, t.Context...)
+		}
+
+		err = errors.Unwrap(err)
+	}
+
+	return cs
+}
+
+// Lookup walks the full wrap chain of err and returns the value of the first
+// Context annotation matching key, searching from the outermost mask inwards.
+func Lookup(err error, key string) (string, bool) {
+	for _, c := range Contexts(err) {
+		if c.Key != key {
+			continue
+		}
+
+		v, ok := c.Val.(string)
+		if !ok {
+			continue
+		}
+
+		return v, true
+	}
+
+	return "", false
+}
@@ -0,0 +1,73 @@
+package tracer
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func Test_Tracer_As(t *testing.T) {
+	testErrorTwo := &Error{Description: "test error two"}
+
+	var err error
+	err = Mask(testErrorTwo)
+	err = Mask(err, Context{Key: "more", Val: "info"})
+
+	var t1 *Error
+	if !errors.As(err, &t1) {
+		t.Fatal("expected errors.As to match *Error")
+	}
+	if t1 != err {
+		t.Fatalf("expected %#v got %#v", err, t1)
+	}
+}
+
+func Test_Tracer_Contexts_Lookup(t *testing.T) {
+	testErrorTwo := &Error{Description: "test error two", Context: []Context{{Key: "code", Val: "testErrorTwo"}}}
+
+	var err error
+	err = Mask(testErrorTwo)
+	err = Mask(err, Context{Key: "re-source", Val: "id"})
+
+	cs := Contexts(err)
+	if len(cs) != 3 {
+		t.Fatalf("expected %#v got %#v", 3, len(cs))
+	}
+
+	testCases := []struct {
+		key   string
+		value string
+		found bool
+	}{
+		// Case 000 finds an annotation added on the outermost mask call.
+		{
+			key:   "re-source",
+			value: "id",
+			found: true,
+		},
+		// Case 001 finds an annotation carried by the innermost error.
+		{
+			key:   "code",
+			value: "testErrorTwo",
+			found: true,
+		},
+		// Case 002 does not find a key that was never annotated.
+		{
+			key:   "missing",
+			value: "",
+			found: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("%03d", i), func(t *testing.T) {
+			v, ok := Lookup(err, tc.key)
+			if ok != tc.found {
+				t.Fatalf("expected %#v got %#v", tc.found, ok)
+			}
+			if v != tc.value {
+				t.Fatalf("expected %#v got %#v", tc.value, v)
+			}
+		})
+	}
+}
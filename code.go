@@ -0,0 +1,90 @@
+package tracer
+
+import "errors"
+
+// codeContextKey is the well-known Context key under which a registered error
+// code is stored, analogous to Cosmos SDK's ABCIInfo pattern.
+const codeContextKey = "code"
+
+type codeInfo struct {
+	grpc int
+	http int
+}
+
+var codeRegistry = map[string]codeInfo{}
+
+// RegisterStatus and GRPCCode are the confirmed public surface for this
+// subsystem, not the originally proposed Register and GRPCStatus
+// (*status.Status): Register was taken by the codespace registry added for
+// the ABCIInfo-style extraction (see registry.go), and GRPCCode returns a
+// plain int rather than *status.Status to keep this package free of a
+// google.golang.org/grpc dependency (see the GRPCCode doc comment).
+
+// RegisterStatus declares the transport codes for a given error kind, as
+// returned by Code, so that GRPCCode and HTTPStatus can translate *Error
+// values consistently across gRPC, HTTP and CLI boundaries. Applications are expected to call
+// RegisterStatus once per kind during program initialization. The built-in
+// kinds returned by BadParameter, NotFound, AlreadyExists, AccessDenied,
+// Aborted and Unavailable are already registered. grpcCode is expected to be
+// one of the canonical google.golang.org/grpc/codes.Code values; it is typed
+// as a plain int, not codes.Code, so that this package has no dependency on
+// grpc-go.
+func RegisterStatus(kind string, grpcCode int, httpCode int) {
+	codeRegistry[kind] = codeInfo{grpc: grpcCode, http: httpCode}
+}
+
+// Code walks the wrap chain of err and returns the innermost registered error
+// code, or "" if none of the wrapped errors carry one.
+func Code(err error) string {
+	var code string
+
+	for err != nil {
+		if t, ok := err.(*Error); ok {
+			for _, c := range t.Context {
+				if c.Key == codeContextKey {
+					if v, ok := c.Val.(string); ok {
+						code = v
+					}
+				}
+			}
+		}
+
+		err = errors.Unwrap(err)
+	}
+
+	return code
+}
+
+// grpcCodeUnknown is the canonical google.golang.org/grpc/codes.Code value
+// for Unknown, returned by GRPCCode when err carries no registered code.
+// Defaulting to 0 (OK) would be a footgun: status.Error(codes.OK, msg)
+// returns a nil error in grpc-go, so an unmapped error would silently turn
+// into an RPC success instead of surfacing as a server error.
+const grpcCodeUnknown = 2
+
+// GRPCCode returns the registered gRPC status code for err's innermost
+// registered error code, or grpcCodeUnknown (2) if none is registered. It
+// intentionally returns a plain int rather than *status.Status or codes.Code
+// to keep this package free of a google.golang.org/grpc dependency; the
+// returned value is the canonical google.golang.org/grpc/codes.Code integer
+// registered via RegisterStatus, so callers that already import grpc can
+// cast it directly, e.g. codes.Code(tracer.GRPCCode(err)).
+func GRPCCode(err error) int {
+	i, ok := codeRegistry[Code(err)]
+	if !ok {
+		return grpcCodeUnknown
+	}
+
+	return i.grpc
+}
+
+// HTTPStatus returns the registered HTTP status code for err's innermost
+// registered error code, or 500 if none is registered.
+func HTTPStatus(err error) int {
+	i, ok := codeRegistry[Code(err)]
+	if !ok {
+		return 500
+	}
+
+	return i.http
+}
@@ -0,0 +1,75 @@
+package tracer
+
+import (
+	"fmt"
+	"testing"
+)
+
+func Test_Tracer_Code(t *testing.T) {
+	RegisterStatus("testAlreadyExistsError", 6, 409)
+	RegisterStatus("testNotFoundError", 5, 404)
+
+	var (
+		alreadyExistsError = &Error{Description: "already exists", Context: []Context{{Key: "code", Val: "testAlreadyExistsError"}}}
+		notFoundError      = &Error{Description: "not found", Context: []Context{{Key: "code", Val: "testNotFoundError"}}}
+	)
+
+	testCases := []struct {
+		errFunc  func() error
+		code     string
+		grpc     int
+		httpCode int
+	}{
+		// Case 000 ensures that an error without a registered code produces
+		// empty defaults, and that GRPCCode defaults to Unknown rather than
+		// OK so an unmapped error cannot be mistaken for RPC success.
+		{
+			errFunc: func() error {
+				return fmt.Errorf("some error")
+			},
+			code:     "",
+			grpc:     2,
+			httpCode: 500,
+		},
+		// Case 001 ensures that a registered code is returned unchanged.
+		{
+			errFunc: func() error {
+				return Mask(alreadyExistsError)
+			},
+			code:     "testAlreadyExistsError",
+			grpc:     6,
+			httpCode: 409,
+		},
+		// Case 002 ensures that the innermost registered code wins when an
+		// error is masked multiple times with additional context on top.
+		{
+			errFunc: func() error {
+				var err error
+
+				err = Mask(notFoundError)
+				err = Mask(err, Context{Key: "code", Val: "testAlreadyExistsError"})
+
+				return err
+			},
+			code:     "testNotFoundError",
+			grpc:     5,
+			httpCode: 404,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("%03d", i), func(t *testing.T) {
+			err := tc.errFunc()
+
+			if Code(err) != tc.code {
+				t.Fatalf("expected %#v got %#v", tc.code, Code(err))
+			}
+			if GRPCCode(err) != tc.grpc {
+				t.Fatalf("expected %#v got %#v", tc.grpc, GRPCCode(err))
+			}
+			if HTTPStatus(err) != tc.httpCode {
+				t.Fatalf("expected %#v got %#v", tc.httpCode, HTTPStatus(err))
+			}
+		})
+	}
+}
@@ -0,0 +1,8 @@
+package tracer
+
+// Context represents a single piece of contextual information attached to an
+// *Error while it is masked along the error handling path.
+type Context struct {
+	Key string `json:"key"`
+	Val any    `json:"val"`
+}
@@ -1,15 +1,20 @@
 package tracer
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"errors"
+)
 
 // Error provides a traceable error instance that can be annotated with
 // arbitrary contextual information along the error handling path.
 type Error struct {
 	Context     []Context
 	Description string
+	Codespace   string
 
-	cause error
-	trace []string
+	cause  error
+	causes []error
+	trace  []Frame
 }
 
 // Copy creates a runtime copy of the underlying *Error{} instance.
@@ -17,9 +22,11 @@ func (e *Error) Copy() *Error {
 	return &Error{
 		Context:     append([]Context{}, e.Context...),
 		Description: e.Description,
+		Codespace:   e.Codespace,
 
-		cause: e.cause,
-		trace: append([]string{}, e.trace...),
+		cause:  e.cause,
+		causes: append([]error{}, e.causes...),
+		trace:  append([]Frame{}, e.trace...),
 	}
 }
 
@@ -32,29 +39,90 @@ func (e *Error) Error() string {
 	return "ERROR"
 }
 
+// Is reports whether x matches e's cause. For a joined *Error, as created by
+// Join, x is compared against every branch so that errors.Is finds a match
+// regardless of which branch carries it. If both e's and x's cause are
+// registered sentinels, created by Register, they are compared by their
+// (Codespace, code) identity instead of pointer identity, so a sentinel
+// survives a JSON round-trip across process boundaries.
 func (e *Error) Is(x error) bool {
+	if len(e.causes) > 0 {
+		for _, c := range e.causes {
+			if errors.Is(c, x) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	ec, eo := cause(e).(*Error)
+	xc, xo := cause(x).(*Error)
+	if eo && xo && ec.Codespace != "" && xc.Codespace != "" {
+		return ec.Codespace == xc.Codespace && Code(ec) == Code(xc)
+	}
+
 	return cause(e) == cause(x)
 }
 
 // MarshalJSON returns the JSON representation of a non nil *Error type, or {}.
+// A joined *Error additionally renders its branches as a "branches" tree
+// rather than flattening them into the description.
 func (e *Error) MarshalJSON() ([]byte, error) {
 	if e == nil {
 		return []byte("{}"), nil
 	}
 
+	var branches []json.RawMessage
+	for _, c := range e.causes {
+		branches = append(branches, json.RawMessage(Json(c)))
+	}
+
 	return json.Marshal(struct {
-		Context     []Context `json:"context,omitempty"`
-		Description string    `json:"description,omitempty"`
-		Trace       []string  `json:"trace,omitempty"`
+		Context     []Context         `json:"context,omitempty"`
+		Description string            `json:"description,omitempty"`
+		Codespace   string            `json:"codespace,omitempty"`
+		Trace       []string          `json:"trace,omitempty"`
+		Branches    []json.RawMessage `json:"branches,omitempty"`
 	}{
 		Context:     e.Context,
 		Description: e.Error(),
-		Trace:       e.trace,
+		Codespace:   e.Codespace,
+		Trace:       frameStrings(e.trace),
+		Branches:    branches,
 	})
 }
 
-// Unwrap returns the error's root cause. That is the first masked error.
+func frameStrings(frames []Frame) []string {
+	if len(frames) == 0 {
+		return nil
+	}
+
+	s := make([]string, len(frames))
+	for i, f := range frames {
+		s[i] = f.String()
+	}
+
+	return s
+}
+
+// StackTrace returns the frames captured while masking e, oldest call site
+// first, satisfying StackTracer.
+func (e *Error) StackTrace() []Frame {
+	return append([]Frame{}, e.trace...)
+}
+
+// Unwrap returns the error's root cause. That is the first masked error. For a
+// joined *Error with more than one branch this returns nil; use errors.Is or
+// Branches to inspect individual branches instead.
 func (e *Error) Unwrap() error {
+	if len(e.causes) == 1 {
+		return e.causes[0]
+	}
+	if len(e.causes) > 1 {
+		return nil
+	}
+
 	return e.cause
 }
 
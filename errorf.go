@@ -0,0 +1,29 @@
+package tracer
+
+import "fmt"
+
+// Errorf formats an error according to format and args, in the style of
+// fmt.Errorf, and returns a traced *Error whose trace is initialized with the
+// call site. A single %w verb is supported and makes the wrapped error
+// discoverable via Unwrap, errors.Is and errors.As, same as fmt.Errorf; its
+// own trace is left untouched, so the returned error only adds the new call
+// site on top instead of duplicating frames already recorded deeper in the
+// chain. If format contains more than one %w verb the plain, untraced result
+// of fmt.Errorf is returned instead, matching its multi-error semantics.
+func Errorf(format string, args ...any) error {
+	wrapped := fmt.Errorf(format, args...)
+
+	if _, ok := interface{}(wrapped).(interface{ Unwrap() []error }); ok {
+		return wrapped
+	}
+
+	e := &Error{Description: wrapped.Error()}
+
+	if u, ok := interface{}(wrapped).(interface{ Unwrap() error }); ok {
+		e.cause = u.Unwrap()
+	}
+
+	e.trace = append(e.trace, framer.Capture(2)...)
+
+	return e
+}
@@ -0,0 +1,68 @@
+package tracer
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func Test_Tracer_Errorf(t *testing.T) {
+	err := Errorf("boom")
+
+	t1, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("expected %#v got %#v", "*Error", err)
+	}
+	if t1.Error() != "boom" {
+		t.Fatalf("expected %#v got %#v", "boom", t1.Error())
+	}
+	if len(t1.trace) != 1 {
+		t.Fatalf("expected %#v got %#v", 1, len(t1.trace))
+	}
+	if errors.Unwrap(t1) != nil {
+		t.Fatal("expected no wrapped cause without a %w verb")
+	}
+}
+
+func Test_Tracer_Errorf_Wrap(t *testing.T) {
+	cause := fmt.Errorf("root cause")
+	err := Errorf("boom: %w", cause)
+
+	if !errors.Is(err, cause) {
+		t.Fatal("expected errors.Is to find the %w argument")
+	}
+
+	t1 := err.(*Error)
+	if t1.Error() != "boom: root cause" {
+		t.Fatalf("expected %#v got %#v", "boom: root cause", t1.Error())
+	}
+	if len(t1.trace) != 1 {
+		t.Fatalf("expected %#v got %#v", 1, len(t1.trace))
+	}
+}
+
+func Test_Tracer_Errorf_Wrap_TracerError(t *testing.T) {
+	inner := Errorf("inner boom")
+	outer := Errorf("outer boom: %w", inner)
+
+	t1 := outer.(*Error)
+	if len(t1.trace) != 1 {
+		t.Fatalf("expected the outer trace to only carry its own call site, got %#v", len(t1.trace))
+	}
+	if !errors.Is(outer, inner) {
+		t.Fatal("expected errors.Is to find the wrapped tracer error")
+	}
+
+	t2 := inner.(*Error)
+	if len(t2.trace) != 1 {
+		t.Fatalf("expected the inner trace to remain untouched, got %#v", len(t2.trace))
+	}
+}
+
+func Test_Tracer_Errorf_MultipleWrap(t *testing.T) {
+	err := Errorf("boom: %w and %w", fmt.Errorf("one"), fmt.Errorf("two"))
+
+	if _, ok := err.(*Error); ok {
+		t.Fatal("expected a plain fmt.Errorf result for more than one %w verb")
+	}
+}
@@ -0,0 +1,80 @@
+package tracer
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Format implements fmt.Formatter so *Error values can be used directly with
+// fmt verbs. %v prints the description, %s prints only the innermost message
+// in the chain, and %+v prints the full chain including context annotations
+// and file:line stack frames, matching the convention popularized by
+// pkg/errors.
+func (e *Error) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if s.Flag('+') {
+			io.WriteString(s, Text(e))
+			return
+		}
+
+		io.WriteString(s, e.Error())
+	case 's':
+		io.WriteString(s, innermost(e))
+	case 'q':
+		fmt.Fprintf(s, "%q", e.Error())
+	}
+}
+
+// Text returns a human readable, multi-line rendering of err's full chain,
+// including context annotations and file:line stack frames, mirroring Json
+// and Stack for direct use with fmt verbs like %+v or log.Printf.
+func Text(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	b := &strings.Builder{}
+
+	first := true
+	for err != nil {
+		if !first {
+			fmt.Fprint(b, "\ncaused by: ")
+		}
+		first = false
+
+		t, ok := err.(*Error)
+		if !ok {
+			fmt.Fprint(b, err.Error())
+			break
+		}
+
+		fmt.Fprint(b, t.Error())
+
+		for _, c := range t.Context {
+			fmt.Fprintf(b, "\n    %s=%v", c.Key, c.Val)
+		}
+
+		for _, f := range t.trace {
+			fmt.Fprintf(b, "\n    %s", f.String())
+		}
+
+		err = errors.Unwrap(t)
+	}
+
+	return b.String()
+}
+
+// innermost returns the message of the deepest error in err's wrap chain.
+func innermost(err error) string {
+	for {
+		u := errors.Unwrap(err)
+		if u == nil {
+			return err.Error()
+		}
+
+		err = u
+	}
+}
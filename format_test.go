@@ -0,0 +1,71 @@
+package tracer
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// Test_Tracer_Format ensures *Error renders correctly for the %v, %s and %+v
+// fmt verbs.
+//
+//	go test ./... -run Test_Tracer_Format -update
+func Test_Tracer_Format(t *testing.T) {
+	defer TrimPrefix("")
+
+	var err error
+	{
+		err = Mask(fmt.Errorf("root cause"), Context{Key: "code", Val: "testError"})
+		err = Mask(err, Context{Key: "re-source", Val: "id"})
+	}
+
+	cwd, e := os.Getwd()
+	if e != nil {
+		t.Fatal(e)
+	}
+	TrimPrefix(cwd)
+
+	testCases := []struct {
+		format string
+		input  any
+	}{
+		// Case 000 %v prints the description.
+		{
+			format: "%v",
+			input:  err,
+		},
+		// Case 001 %s prints only the innermost message.
+		{
+			format: "%s",
+			input:  err,
+		},
+		// Case 002 %+v prints the full chain with context and stack frames.
+		{
+			format: "%+v",
+			input:  err,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("%03d", i), func(t *testing.T) {
+			act := fmt.Sprintf(tc.format, tc.input) + "\n"
+
+			p := filepath.Join("testdata", "format", fmt.Sprintf("case.%03d.golden", i))
+			if *update {
+				if err := os.WriteFile(p, []byte(act), 0644); err != nil { // nolint:gosec
+					t.Fatal(err)
+				}
+			}
+
+			exp, e := os.ReadFile(p)
+			if e != nil {
+				t.Fatal(e)
+			}
+
+			if act != string(exp) {
+				t.Fatalf("expected %#v got %#v", string(exp), act)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package tracer
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// Frame describes a single call site captured while masking an error.
+type Frame struct {
+	File string
+	Line int
+	Func string
+	PC   uintptr
+}
+
+// resolve fills in File, Line and Func from PC if they were not already
+// captured eagerly.
+func (f Frame) resolve() Frame {
+	if f.File != "" || f.PC == 0 {
+		return f
+	}
+
+	if fn := runtime.FuncForPC(f.PC); fn != nil {
+		f.File, f.Line = fn.FileLine(f.PC)
+		f.Func = fn.Name()
+	}
+
+	return f
+}
+
+// String formats the frame as "file:line", resolving File and Line from PC
+// first if they were not already captured eagerly.
+func (f Frame) String() string {
+	r := f.resolve()
+	return fmt.Sprintf("%s:%d", trimPath(r.File), r.Line)
+}
+
+// Framer captures the single call site skip frames up the stack, counting the
+// Capture implementation itself as 0 and its caller as 1, the same numbering
+// runtime.Caller uses when called from inside Capture. It deliberately returns one frame
+// per call rather than walking the whole goroutine stack: mask and Join call
+// Capture once per wrap site, so *Error.trace accumulates one frame per call
+// to Mask/Join and the full path an error traveled is the concatenation of
+// those calls, not a single-shot stack walk. Implementations that need the
+// full goroutine stack in one call, such as Recover turning a panic into a
+// trace, walk runtime.Callers directly instead of going through Framer.
+type Framer interface {
+	Capture(skip int) []Frame
+}
+
+// StackTracer is implemented by errors that expose the frames captured along
+// their error handling path, mirroring the convention used by pkg/errors and
+// pingcap/errors so other libraries can assert against it.
+type StackTracer interface {
+	StackTrace() []Frame
+}
+
+var framer Framer = FullFramer{}
+
+// SetFramer overrides the package-level Framer used by Mask and Join to
+// capture stack frames. It is meant to be called once during program
+// initialization and is not safe for concurrent use with error creation.
+func SetFramer(f Framer) {
+	framer = f
+}
+
+// FullFramer resolves file, line and function eagerly at capture time.
+type FullFramer struct{}
+
+func (FullFramer) Capture(skip int) []Frame {
+	var pcs [1]uintptr
+
+	n := runtime.Callers(skip+1, pcs[:])
+	if n == 0 {
+		return nil
+	}
+
+	f, _ := runtime.CallersFrames(pcs[:n]).Next()
+
+	return []Frame{{File: f.File, Line: f.Line, Func: f.Function, PC: f.PC}}
+}
+
+// PCFramer only records the raw program counter at capture time and defers
+// symbolization until the frame is formatted, e.g. via Frame.String,
+// MarshalJSON or Stack.
+type PCFramer struct{}
+
+func (PCFramer) Capture(skip int) []Frame {
+	var pcs [1]uintptr
+
+	n := runtime.Callers(skip+1, pcs[:])
+	if n == 0 {
+		return nil
+	}
+
+	return []Frame{{PC: pcs[0]}}
+}
+
+var trimPrefix string
+
+// TrimPrefix replaces any leading path prefix matching prefix with
+// "--REPLACED--" whenever a frame is formatted, turning the path munging that
+// golden-file tests used to apply by hand into a first-class runtime feature.
+func TrimPrefix(prefix string) {
+	trimPrefix = prefix
+}
+
+// TrimModule sets the trim prefix to the directory of the file calling
+// TrimModule, which in practice is the root of the current module.
+func TrimModule() {
+	_, file, _, ok := runtime.Caller(1)
+	if !ok {
+		return
+	}
+
+	trimPrefix = filepath.Dir(file)
+}
+
+func trimPath(file string) string {
+	if trimPrefix == "" || !strings.HasPrefix(file, trimPrefix) {
+		return file
+	}
+
+	return "--REPLACED--" + strings.TrimPrefix(file, trimPrefix)
+}
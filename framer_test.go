@@ -0,0 +1,62 @@
+package tracer
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func Test_Tracer_Framer_FullFramer(t *testing.T) {
+	defer SetFramer(FullFramer{})
+
+	err := Mask(fmt.Errorf("boom"))
+
+	t1 := err.(*Error)
+	// Capture records exactly the Mask call site, not the whole goroutine
+	// stack; see the Framer doc comment.
+	if len(t1.trace) != 1 {
+		t.Fatalf("expected %#v got %#v", 1, len(t1.trace))
+	}
+	if t1.trace[0].File == "" {
+		t.Fatal("expected FullFramer to resolve the file eagerly")
+	}
+	if !strings.Contains(t1.trace[0].Func, "Test_Tracer_Framer_FullFramer") {
+		t.Fatalf("expected the captured frame to resolve the function name, got %#v", t1.trace[0].Func)
+	}
+}
+
+func Test_Tracer_Framer_PCFramer(t *testing.T) {
+	SetFramer(PCFramer{})
+	defer SetFramer(FullFramer{})
+
+	err := Mask(fmt.Errorf("boom"))
+
+	t1 := err.(*Error)
+	if len(t1.trace) != 1 {
+		t.Fatalf("expected %#v got %#v", 1, len(t1.trace))
+	}
+	if t1.trace[0].File != "" {
+		t.Fatal("expected PCFramer to defer symbolization")
+	}
+	if t1.trace[0].PC == 0 {
+		t.Fatal("expected PCFramer to capture a program counter")
+	}
+	if !strings.Contains(t1.trace[0].String(), "framer_test.go") {
+		t.Fatalf("expected the formatted frame to resolve the call site, got %#v", t1.trace[0].String())
+	}
+}
+
+func Test_Tracer_Framer_TrimPrefix(t *testing.T) {
+	defer TrimPrefix("")
+
+	err := Mask(fmt.Errorf("boom"))
+	t1 := err.(*Error)
+
+	prefix := t1.trace[0].File[:strings.LastIndex(t1.trace[0].File, "/")]
+	TrimPrefix(prefix)
+
+	s := t1.trace[0].String()
+	if !strings.HasPrefix(s, "--REPLACED--") {
+		t.Fatalf("expected trimmed prefix, got %#v", s)
+	}
+}
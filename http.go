@@ -0,0 +1,38 @@
+package tracer
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// WriteError writes err to w as JSON, setting the response status code to
+// err's registered HTTPStatus. The body always carries err's context and
+// description; the trace is only included when debug is true, so production
+// responses don't leak file paths to clients by default.
+func WriteError(w http.ResponseWriter, err error, debug bool) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(HTTPStatus(err))
+
+	if debug {
+		w.Write([]byte(Json(err)))
+		return
+	}
+
+	t, ok := err.(*Error)
+	if !ok {
+		t = &Error{Description: err.Error()}
+	}
+
+	b, e := json.Marshal(struct {
+		Context     []Context `json:"context,omitempty"`
+		Description string    `json:"description,omitempty"`
+	}{
+		Context:     t.Context,
+		Description: t.Error(),
+	})
+	if e != nil {
+		panic(e)
+	}
+
+	w.Write(b)
+}
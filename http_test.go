@@ -0,0 +1,38 @@
+package tracer
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func Test_Tracer_WriteError(t *testing.T) {
+	err := NotFound("user %s", "123")
+
+	w := httptest.NewRecorder()
+	WriteError(w, err, false)
+
+	if w.Code != 404 {
+		t.Fatalf("expected %#v got %#v", 404, w.Code)
+	}
+	if strings.Contains(w.Body.String(), `"trace"`) {
+		t.Fatalf("expected no trace without debug, got %#v", w.Body.String())
+	}
+	if !strings.Contains(w.Body.String(), "user 123") {
+		t.Fatalf("expected the description in the body, got %#v", w.Body.String())
+	}
+}
+
+func Test_Tracer_WriteError_Debug(t *testing.T) {
+	err := NotFound("user %s", "123")
+
+	w := httptest.NewRecorder()
+	WriteError(w, err, true)
+
+	if w.Code != 404 {
+		t.Fatalf("expected %#v got %#v", 404, w.Code)
+	}
+	if !strings.Contains(w.Body.String(), `"trace"`) {
+		t.Fatalf("expected the trace with debug, got %#v", w.Body.String())
+	}
+}
@@ -2,6 +2,7 @@ package tracer
 
 type Interface interface {
 	Error() string
+	As(any) bool
 	Is(error) bool
 	Unwrap() error
 }
@@ -0,0 +1,60 @@
+package tracer
+
+import (
+	"strings"
+)
+
+// Join returns an *Error that wraps every non-nil error in errs, mirroring
+// the semantics of Go 1.20's errors.Join. The returned error's trace is
+// initialized with the Join call site. errors.Is considers every branch a
+// match; errors.As only matches the joined *Error itself, since Unwrap returns
+// nil for more than one branch. Unwrap preserves the single-parent behavior of
+// Mask when only one non-nil error was given.
+func Join(errs ...error) error {
+	var filtered []error
+	for _, err := range errs {
+		if err != nil {
+			filtered = append(filtered, err)
+		}
+	}
+
+	if len(filtered) == 0 {
+		return nil
+	}
+
+	if len(filtered) == 1 {
+		t, ok := filtered[0].(*Error)
+		if !ok {
+			t = &Error{Description: filtered[0].Error(), cause: filtered[0]}
+		}
+
+		return mask(t)
+	}
+
+	descriptions := make([]string, len(filtered))
+	for i, err := range filtered {
+		descriptions[i] = err.Error()
+	}
+
+	n := &Error{
+		Description: strings.Join(descriptions, "\n"),
+		causes:      filtered,
+	}
+
+	n.trace = append(n.trace, framer.Capture(2)...)
+
+	return n
+}
+
+// Branches returns every non-nil branch of a joined *Error created by Join,
+// or nil if err is not a joined error. Unlike Unwrap, which only exposes a
+// single cause for backward compatibility, Branches exposes every cause a
+// joined error was constructed from.
+func Branches(err error) []error {
+	t, ok := err.(*Error)
+	if !ok || len(t.causes) == 0 {
+		return nil
+	}
+
+	return t.causes
+}
@@ -0,0 +1,110 @@
+package tracer
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func Test_Tracer_Join(t *testing.T) {
+	var (
+		testErrorOne = &Error{Description: "testErrorOne"}
+		testErrorTwo = &Error{Description: "testErrorTwo"}
+	)
+
+	testCases := []struct {
+		errFunc  func() error
+		nilError bool
+		causes   []error
+	}{
+		// Case 000 joining no errors returns nil.
+		{
+			errFunc: func() error {
+				return Join()
+			},
+			nilError: true,
+		},
+		// Case 001 joining only nil errors returns nil.
+		{
+			errFunc: func() error {
+				return Join(nil, nil)
+			},
+			nilError: true,
+		},
+		// Case 002 joining a single error behaves like Mask.
+		{
+			errFunc: func() error {
+				return Join(testErrorOne)
+			},
+			causes: []error{testErrorOne},
+		},
+		// Case 003 joining multiple errors preserves every branch.
+		{
+			errFunc: func() error {
+				return Join(testErrorOne, testErrorTwo)
+			},
+			causes: []error{testErrorOne, testErrorTwo},
+		},
+		// Case 004 joining skips nil errors interleaved with real ones.
+		{
+			errFunc: func() error {
+				return Join(testErrorOne, nil, testErrorTwo)
+			},
+			causes: []error{testErrorOne, testErrorTwo},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("%03d", i), func(t *testing.T) {
+			err := tc.errFunc()
+
+			if tc.nilError {
+				if err != nil {
+					t.Fatalf("expected nil got %#v", err)
+				}
+				return
+			}
+
+			for _, c := range tc.causes {
+				if !errors.Is(err, c) {
+					t.Fatalf("expected %#v to match %#v", err, c)
+				}
+			}
+		})
+	}
+}
+
+func Test_Tracer_Branches(t *testing.T) {
+	var (
+		testErrorOne = &Error{Description: "testErrorOne"}
+		testErrorTwo = &Error{Description: "testErrorTwo"}
+	)
+
+	err := Mask(Join(testErrorOne, testErrorTwo), Context{Key: "more", Val: "info"})
+
+	bs := Branches(err)
+	if len(bs) != 2 {
+		t.Fatalf("expected %#v got %#v", 2, len(bs))
+	}
+	if bs[0] != error(testErrorOne) || bs[1] != error(testErrorTwo) {
+		t.Fatalf("expected branches to preserve both inputs in order, got %#v", bs)
+	}
+
+	if Branches(testErrorOne) != nil {
+		t.Fatal("expected a non-joined error to have no branches")
+	}
+}
+
+func Test_Tracer_Join_Stack(t *testing.T) {
+	var (
+		testErrorOne = &Error{Description: "testErrorOne"}
+		testErrorTwo = &Error{Description: "testErrorTwo"}
+	)
+
+	err := Join(testErrorOne, testErrorTwo)
+
+	s := Stack(err)
+	if s == "[]" || s == "null" {
+		t.Fatalf("expected a tree of causes got %#v", s)
+	}
+}
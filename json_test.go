@@ -25,7 +25,11 @@ func Test_Tracer_Json_Interface(t *testing.T) {
 
 // Test_Tracer_Json_String ensures that the error wrapping, error matching and
 // respective JSON encoding works properly for the *Error type, including its
-// context and tracing annotations.
+// context and tracing annotations. Other tests in this package cover Mask
+// behavior outside the JSON encoding, such as context lookup, error codes and
+// joined branches. The golden files pin the recorded file:line frames of this
+// file, so moving a Mask call below changes their expected output and they
+// have to be regenerated.
 //
 //	go test ./... -run Test_Tracer_Json_String -update
 func Test_Tracer_Json_String(t *testing.T) {
@@ -35,6 +39,9 @@ func Test_Tracer_Json_String(t *testing.T) {
 		testErrorThree     = fmt.Errorf("executing \".github/dependabot.yaml\"")
 		testErrorFour      = &Error{}
 		alreadyExistsError = &Error{Description: "alreadyExistsError", Context: []Context{{Key: "code", Val: "invalidArgument"}}}
+
+		joinedBranchOne = &Error{Description: "branch one", Context: []Context{{Key: "code", Val: "one"}}}
+		joinedBranchTwo = &Error{Description: "branch two", Context: []Context{{Key: "code", Val: "two"}}}
 	)
 
 	testCases := []struct {
@@ -229,6 +236,21 @@ func Test_Tracer_Json_String(t *testing.T) {
 			cau: testErrorFour,
 			neg: alreadyExistsError,
 		},
+		// Case 015 joins two errors carrying overlapping context keys. The
+		// second branch must be reachable through errors.Is even though neither
+		// branch is the single cause tracked by Unwrap.
+		{
+			err: Join(joinedBranchOne, joinedBranchTwo),
+			cau: joinedBranchTwo,
+			neg: alreadyExistsError,
+		},
+		// Case 016 masks a joined error, appending the caller frame to the
+		// outer trace without flattening the branches.
+		{
+			err: Mask(Join(joinedBranchOne, joinedBranchTwo)),
+			cau: joinedBranchOne,
+			neg: alreadyExistsError,
+		},
 	}
 
 	for i, tc := range testCases {
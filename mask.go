@@ -1,10 +1,5 @@
 package tracer
 
-import (
-	"fmt"
-	"runtime"
-)
-
 func Mask(e error, c ...Context) error {
 	if e == nil {
 		return nil
@@ -36,10 +31,7 @@ func mask(e *Error, c ...Context) *Error {
 		n.Context = append(n.Context, c...)
 	}
 
-	{
-		_, f, l, _ := runtime.Caller(2)
-		n.trace = append(n.trace, fmt.Sprintf("%s:%d", f, l))
-	}
+	n.trace = append(n.trace, framer.Capture(3)...)
 
 	return n
 }
@@ -0,0 +1,91 @@
+package tracer
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// Recover is meant to be used as `defer tracer.Recover(&err)` so a panic
+// raised by the deferring function, or by anything it calls on the same
+// goroutine, surfaces as a traced *Error instead of crashing the process. A
+// panic on another goroutine is not recovered. When no panic occurred Recover does nothing. If the recovered
+// value is already an *Error its existing trace is preserved and the frames
+// from the panic site upward are appended on top; otherwise a new *Error is
+// constructed from the panic value.
+func Recover(errp *error) {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	t, ok := r.(*Error)
+	if !ok {
+		var description string
+		if e, ok := r.(error); ok {
+			description = e.Error()
+		} else {
+			description = fmt.Sprint(r)
+		}
+
+		t = &Error{Description: description}
+	}
+
+	t.Context = append(t.Context,
+		Context{Key: "panic", Val: "true"},
+		Context{Key: "goroutine", Val: goroutineID()},
+	)
+	t.trace = append(t.trace, panicFrames()...)
+
+	*errp = t
+}
+
+// maxPanicFrames bounds how many frames panicFrames walks above the panic
+// site, the same cap pkg/errors and similar stack-capture libraries use.
+const maxPanicFrames = 32
+
+// panicFrames walks the stack from the frame that called panic() up to the
+// top of the goroutine, skipping the runtime.gopanic plumbing frame and
+// Recover's own frame. It always resolves frames eagerly, independent of the
+// package-level Framer, since a recovered panic is already the slow path.
+func panicFrames() []Frame {
+	var pcs [maxPanicFrames]uintptr
+
+	n := runtime.Callers(4, pcs[:])
+	if n == 0 {
+		return nil
+	}
+
+	frames := runtime.CallersFrames(pcs[:n])
+
+	out := make([]Frame, 0, n)
+	for {
+		f, more := frames.Next()
+		out = append(out, Frame{File: f.File, Line: f.Line, Func: f.Function, PC: f.PC})
+		if !more {
+			break
+		}
+	}
+
+	return out
+}
+
+// Go runs fn with Recover installed, so both the error fn returns and any
+// panic fn raises surface uniformly as a traced *Error. It is meant for
+// goroutine-pool code that wants to treat returned errors and panics the
+// same way, e.g. `go func() { errc <- tracer.Go(fn) }()`.
+func Go(fn func() error) (err error) {
+	defer Recover(&err)
+
+	return Mask(fn())
+}
+
+func goroutineID() string {
+	var buf [64]byte
+
+	n := runtime.Stack(buf[:], false)
+
+	var id string
+	fmt.Sscanf(string(buf[:n]), "goroutine %s ", &id)
+
+	return id
+}
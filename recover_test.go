@@ -0,0 +1,109 @@
+package tracer
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func Test_Tracer_Recover_Panic(t *testing.T) {
+	var err error
+
+	func() {
+		defer Recover(&err)
+		panic("boom")
+	}()
+
+	t1, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("expected %#v got %#v", "*Error", err)
+	}
+	if t1.Error() != "boom" {
+		t.Fatalf("expected %#v got %#v", "boom", t1.Error())
+	}
+	if len(t1.trace) < 2 {
+		t.Fatalf("expected more than one frame, got %#v", len(t1.trace))
+	}
+	if !strings.Contains(t1.trace[0].File, "recover_test.go") {
+		t.Fatalf("expected the first frame to be the panic site, got %#v", t1.trace[0].File)
+	}
+	if !strings.Contains(t1.trace[0].Func, "Test_Tracer_Recover_Panic") {
+		t.Fatalf("expected the first frame to name the panicking function, got %#v", t1.trace[0].Func)
+	}
+	if strings.Contains(t1.trace[0].Func, "runtime.gopanic") {
+		t.Fatal("expected the gopanic plumbing frame to be skipped")
+	}
+
+	var found bool
+	for _, c := range t1.Context {
+		if c.Key == "panic" && c.Val == "true" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatal("expected the panic context marker to be set")
+	}
+}
+
+func Test_Tracer_Recover_NoPanic(t *testing.T) {
+	var err error
+
+	func() {
+		defer Recover(&err)
+	}()
+
+	if err != nil {
+		t.Fatalf("expected %#v got %#v", nil, err)
+	}
+}
+
+func Test_Tracer_Recover_PreservesExistingError(t *testing.T) {
+	var err error
+
+	inner := NotFound("user %s", "123")
+
+	func() {
+		defer Recover(&err)
+		panic(inner)
+	}()
+
+	t1, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("expected %#v got %#v", "*Error", err)
+	}
+	if len(t1.trace) < 2 {
+		t.Fatalf("expected the panic site to be appended to the existing trace, got %#v", len(t1.trace))
+	}
+	if t1.trace[0] != inner.trace[0] {
+		t.Fatalf("expected the existing trace to be preserved, got %#v", t1.trace[0])
+	}
+	if !strings.Contains(t1.trace[1].Func, "Test_Tracer_Recover_PreservesExistingError") {
+		t.Fatalf("expected the panic site to be appended after it, got %#v", t1.trace[1].Func)
+	}
+	if Code(t1) != KindNotFound {
+		t.Fatalf("expected %#v got %#v", KindNotFound, Code(t1))
+	}
+}
+
+func Test_Tracer_Go(t *testing.T) {
+	err := Go(func() error {
+		return fmt.Errorf("boom")
+	})
+	if err == nil || err.Error() != "boom" {
+		t.Fatalf("expected %#v got %#v", "boom", err)
+	}
+
+	err = Go(func() error {
+		panic("kaboom")
+	})
+	if err == nil || err.Error() != "kaboom" {
+		t.Fatalf("expected %#v got %#v", "kaboom", err)
+	}
+
+	err = Go(func() error {
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("expected %#v got %#v", nil, err)
+	}
+}
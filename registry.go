@@ -0,0 +1,70 @@
+package tracer
+
+import "errors"
+
+// registryKey identifies a sentinel *Error by its codespace and code,
+// mirroring cosmos-sdk's errors package.
+type registryKey struct {
+	codespace string
+	code      string
+}
+
+var registry = map[registryKey]*Error{}
+
+// Register declares a sentinel *Error identified by the given codespace and
+// code, and records it in a package registry so Info can later recover its
+// codespace, code and description, even after the error crossed a process
+// boundary as JSON and lost its original pointer identity. Applications are
+// expected to call Register once per sentinel during program initialization.
+func Register(codespace string, code string, description string) *Error {
+	e := &Error{
+		Codespace:   codespace,
+		Description: description,
+		Context:     []Context{{Key: codeContextKey, Val: code}},
+	}
+
+	registry[registryKey{codespace, code}] = e
+
+	return e
+}
+
+// Info walks err's cause chain and returns the codespace, code and
+// description of the innermost registered sentinel, alongside the merged
+// "file:line" trace collected across every mask along the way, analogous to
+// cosmos-sdk's ABCIInfo. When debug is false the returned description is
+// replaced by the sentinel's registered description instead of err's
+// potentially sensitive runtime message, so services can return it to
+// clients while still logging the full trace internally.
+func Info(err error, debug bool) (codespace string, code string, description string, trace []string) {
+	var sentinel *Error
+	var frames []Frame
+
+	full := err
+	for err != nil {
+		if t, ok := err.(*Error); ok {
+			frames = append(frames, t.trace...)
+			if t.Codespace != "" {
+				sentinel = t
+			}
+		}
+
+		err = errors.Unwrap(err)
+	}
+
+	if sentinel == nil {
+		return "", "", "", frameStrings(frames)
+	}
+
+	codespace = sentinel.Codespace
+	code = Code(sentinel)
+	description = full.Error()
+
+	if !debug {
+		description = sentinel.Error()
+		if reg, ok := registry[registryKey{codespace, code}]; ok {
+			description = reg.Error()
+		}
+	}
+
+	return codespace, code, description, frameStrings(frames)
+}
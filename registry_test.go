@@ -0,0 +1,54 @@
+package tracer
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func Test_Tracer_Register_Info(t *testing.T) {
+	sentinel := Register("testCodespace", "testUserNotFound", "user not found")
+
+	err := Mask(sentinel, Context{Key: "id", Val: "123"})
+
+	codespace, code, description, trace := Info(err, true)
+	if codespace != "testCodespace" {
+		t.Fatalf("expected %#v got %#v", "testCodespace", codespace)
+	}
+	if code != "testUserNotFound" {
+		t.Fatalf("expected %#v got %#v", "testUserNotFound", code)
+	}
+	if description != "user not found" {
+		t.Fatalf("expected %#v got %#v", "user not found", description)
+	}
+	if len(trace) != 1 {
+		t.Fatalf("expected %#v got %#v", 1, len(trace))
+	}
+}
+
+func Test_Tracer_Info_NonDebug_HidesDetail(t *testing.T) {
+	sentinel := Register("testCodespace", "testDetailLeak", "safe description")
+
+	err := Mask(fmt.Errorf("wrapped: %w", Mask(sentinel)))
+
+	_, _, description, _ := Info(err, false)
+	if description != "safe description" {
+		t.Fatalf("expected %#v got %#v", "safe description", description)
+	}
+}
+
+func Test_Tracer_Info_NoSentinel(t *testing.T) {
+	codespace, code, description, trace := Info(fmt.Errorf("boom"), true)
+	if codespace != "" || code != "" || description != "" || trace != nil {
+		t.Fatalf("expected all zero values got %#v %#v %#v %#v", codespace, code, description, trace)
+	}
+}
+
+func Test_Tracer_Register_Is_AcrossInstances(t *testing.T) {
+	original := Mask(Register("testCodespace", "testReconstructed", "reconstructed sentinel"))
+	reconstructed := Register("testCodespace", "testReconstructed", "reconstructed sentinel")
+
+	if !errors.Is(original, reconstructed) {
+		t.Fatal("expected sentinels with the same codespace and code to match regardless of pointer identity")
+	}
+}
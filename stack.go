@@ -2,6 +2,9 @@ package tracer
 
 import "encoding/json"
 
+// Stack returns the marshaled JSON trace of a non nil *Error, or "[]", or
+// "null". A joined *Error, as created by Join, renders its branches as a tree
+// of nested "branches" instead of a flat list of file:line entries.
 func Stack(err error) string {
 	if err == nil {
 		return "null"
@@ -12,7 +15,91 @@ func Stack(err error) string {
 		return "[]"
 	}
 
-	b, e := json.Marshal(t.Stck)
+	if len(t.causes) > 1 {
+		branches := make([]json.RawMessage, len(t.causes))
+		for i, c := range t.causes {
+			branches[i] = json.RawMessage(Stack(c))
+		}
+
+		b, e := json.Marshal(struct {
+			Trace    []string          `json:"trace,omitempty"`
+			Branches []json.RawMessage `json:"branches,omitempty"`
+		}{
+			Trace:    frameStrings(t.trace),
+			Branches: branches,
+		})
+		if e != nil {
+			panic(e)
+		}
+
+		return string(b)
+	}
+
+	b, e := json.Marshal(frameStrings(t.trace))
+	if e != nil {
+		panic(e)
+	}
+
+	return string(b)
+}
+
+// frameDetail is the richer, tooling oriented rendering of a Frame used by
+// StackJSON, carrying the resolved function name alongside file and line.
+type frameDetail struct {
+	File string `json:"file,omitempty"`
+	Line int    `json:"line,omitempty"`
+	Func string `json:"func,omitempty"`
+}
+
+func frameDetails(frames []Frame) []frameDetail {
+	if len(frames) == 0 {
+		return nil
+	}
+
+	d := make([]frameDetail, len(frames))
+	for i, f := range frames {
+		r := f.resolve()
+		d[i] = frameDetail{File: trimPath(r.File), Line: r.Line, Func: r.Func}
+	}
+
+	return d
+}
+
+// StackJSON returns the same tree shape as Stack, except each frame is
+// rendered as an object carrying file, line and function name instead of a
+// single "file:line" string. It is meant for tooling that wants to inspect
+// frames programmatically rather than display them.
+func StackJSON(err error) string {
+	if err == nil {
+		return "null"
+	}
+
+	t, o := err.(*Error)
+	if !o {
+		return "[]"
+	}
+
+	if len(t.causes) > 1 {
+		branches := make([]json.RawMessage, len(t.causes))
+		for i, c := range t.causes {
+			branches[i] = json.RawMessage(StackJSON(c))
+		}
+
+		b, e := json.Marshal(struct {
+			Trace    []frameDetail     `json:"trace,omitempty"`
+			Branches []json.RawMessage `json:"branches,omitempty"`
+		}{
+			Trace:    frameDetails(t.trace),
+			Branches: branches,
+		})
+		if e != nil {
+			panic(e)
+		}
+
+		return string(b)
+	}
+
+	b, e := json.Marshal(frameDetails(t.trace))
 	if e != nil {
 		panic(e)
 	}
@@ -0,0 +1,54 @@
+package tracer
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func Test_Tracer_Error_StackTrace(t *testing.T) {
+	err := Mask(fmt.Errorf("boom"))
+
+	var st StackTracer = err.(*Error)
+
+	fs := st.StackTrace()
+	if len(fs) != 1 {
+		t.Fatalf("expected %#v got %#v", 1, len(fs))
+	}
+	if !strings.Contains(fs[0].Func, "Test_Tracer_Error_StackTrace") {
+		t.Fatalf("expected the captured frame to carry the call site function, got %#v", fs[0].Func)
+	}
+}
+
+func Test_Tracer_StackJSON(t *testing.T) {
+	if StackJSON(nil) != "null" {
+		t.Fatalf("expected %#v got %#v", "null", StackJSON(nil))
+	}
+	if StackJSON(fmt.Errorf("boom")) != "[]" {
+		t.Fatalf("expected %#v got %#v", "[]", StackJSON(fmt.Errorf("boom")))
+	}
+
+	err := Mask(fmt.Errorf("boom"))
+
+	s := StackJSON(err)
+	if !strings.Contains(s, `"func"`) {
+		t.Fatalf("expected the richer form to carry function names, got %#v", s)
+	}
+	if !strings.Contains(s, "Test_Tracer_StackJSON") {
+		t.Fatalf("expected the richer form to resolve the call site, got %#v", s)
+	}
+}
+
+func Test_Tracer_StackJSON_Join(t *testing.T) {
+	var (
+		testErrorOne = &Error{Description: "testErrorOne"}
+		testErrorTwo = &Error{Description: "testErrorTwo"}
+	)
+
+	err := Join(testErrorOne, testErrorTwo)
+
+	s := StackJSON(err)
+	if !strings.Contains(s, `"branches"`) {
+		t.Fatalf("expected a tree of branches, got %#v", s)
+	}
+}
@@ -0,0 +1,74 @@
+package tracer
+
+import "fmt"
+
+// Well-known error kinds registered with their transport codes at package
+// initialization, returned by the status constructors below.
+const (
+	KindBadParameter  = "bad_parameter"
+	KindNotFound      = "not_found"
+	KindAlreadyExists = "already_exists"
+	KindAccessDenied  = "access_denied"
+	KindAborted       = "aborted"
+	KindUnavailable   = "unavailable"
+)
+
+func init() {
+	RegisterStatus(KindBadParameter, 3, 400)
+	RegisterStatus(KindNotFound, 5, 404)
+	RegisterStatus(KindAlreadyExists, 6, 409)
+	RegisterStatus(KindAccessDenied, 7, 403)
+	RegisterStatus(KindAborted, 10, 409)
+	RegisterStatus(KindUnavailable, 14, 503)
+}
+
+// BadParameter returns a pre-kinded *Error for invalid input, with its trace
+// captured at the call site. format and args are handled like fmt.Sprintf.
+func BadParameter(format string, args ...any) *Error {
+	return newStatus(KindBadParameter, format, args...)
+}
+
+// NotFound returns a pre-kinded *Error for a missing resource, with its trace
+// captured at the call site. format and args are handled like fmt.Sprintf.
+func NotFound(format string, args ...any) *Error {
+	return newStatus(KindNotFound, format, args...)
+}
+
+// AlreadyExists returns a pre-kinded *Error for a conflicting resource, with
+// its trace captured at the call site. format and args are handled like
+// fmt.Sprintf.
+func AlreadyExists(format string, args ...any) *Error {
+	return newStatus(KindAlreadyExists, format, args...)
+}
+
+// AccessDenied returns a pre-kinded *Error for a rejected authorization
+// check, with its trace captured at the call site. format and args are
+// handled like fmt.Sprintf.
+func AccessDenied(format string, args ...any) *Error {
+	return newStatus(KindAccessDenied, format, args...)
+}
+
+// Aborted returns a pre-kinded *Error for an operation aborted due to a
+// conflict, with its trace captured at the call site. format and args are
+// handled like fmt.Sprintf.
+func Aborted(format string, args ...any) *Error {
+	return newStatus(KindAborted, format, args...)
+}
+
+// Unavailable returns a pre-kinded *Error for a temporarily unreachable
+// dependency, with its trace captured at the call site. format and args are
+// handled like fmt.Sprintf.
+func Unavailable(format string, args ...any) *Error {
+	return newStatus(KindUnavailable, format, args...)
+}
+
+func newStatus(kind string, format string, args ...any) *Error {
+	e := &Error{
+		Description: fmt.Sprintf(format, args...),
+		Context:     []Context{{Key: codeContextKey, Val: kind}},
+	}
+
+	e.trace = append(e.trace, framer.Capture(3)...)
+
+	return e
+}
@@ -0,0 +1,83 @@
+package tracer
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func Test_Tracer_Status_Constructors(t *testing.T) {
+	testCases := []struct {
+		errFunc  func() *Error
+		kind     string
+		httpCode int
+	}{
+		// Case 000 BadParameter is kinded and carries the right HTTP status.
+		{
+			errFunc:  func() *Error { return BadParameter("missing %s", "id") },
+			kind:     KindBadParameter,
+			httpCode: 400,
+		},
+		// Case 001 NotFound is kinded and carries the right HTTP status.
+		{
+			errFunc:  func() *Error { return NotFound("user %s", "123") },
+			kind:     KindNotFound,
+			httpCode: 404,
+		},
+		// Case 002 AlreadyExists is kinded and carries the right HTTP status.
+		{
+			errFunc:  func() *Error { return AlreadyExists("user %s", "123") },
+			kind:     KindAlreadyExists,
+			httpCode: 409,
+		},
+		// Case 003 AccessDenied is kinded and carries the right HTTP status.
+		{
+			errFunc:  func() *Error { return AccessDenied("role %s", "admin") },
+			kind:     KindAccessDenied,
+			httpCode: 403,
+		},
+		// Case 004 Aborted is kinded and carries the right HTTP status.
+		{
+			errFunc:  func() *Error { return Aborted("transaction %s", "1") },
+			kind:     KindAborted,
+			httpCode: 409,
+		},
+		// Case 005 Unavailable is kinded and carries the right HTTP status.
+		{
+			errFunc:  func() *Error { return Unavailable("backend %s", "db") },
+			kind:     KindUnavailable,
+			httpCode: 503,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("%03d", i), func(t *testing.T) {
+			err := tc.errFunc()
+
+			if Code(err) != tc.kind {
+				t.Fatalf("expected %#v got %#v", tc.kind, Code(err))
+			}
+			if HTTPStatus(err) != tc.httpCode {
+				t.Fatalf("expected %#v got %#v", tc.httpCode, HTTPStatus(err))
+			}
+			if len(err.trace) != 1 {
+				t.Fatalf("expected %#v got %#v", 1, len(err.trace))
+			}
+		})
+	}
+}
+
+func Test_Tracer_Status_Wrapped(t *testing.T) {
+	notFound := NotFound("user %s", "123")
+	err := Mask(notFound)
+
+	if !errors.Is(err, notFound) {
+		t.Fatalf("expected %#v to match %#v", err, notFound)
+	}
+	if Code(err) != KindNotFound {
+		t.Fatalf("expected %#v got %#v", KindNotFound, Code(err))
+	}
+	if HTTPStatus(err) != 404 {
+		t.Fatalf("expected %#v got %#v", 404, HTTPStatus(err))
+	}
+}